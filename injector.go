@@ -1,15 +1,41 @@
 package fault
 
 import (
+	"bufio"
+	"bytes"
+	"context"
 	"errors"
+	"fmt"
+	"io"
+	"math"
 	"math/rand"
+	"net"
 	"net/http"
+	"net/http/httputil"
+	"os"
+	"strings"
+	"sync"
+	"syscall"
 	"time"
 )
 
 var (
 	// ErrInvalidHTTPCode returns when an invalid http status code is provided.
 	ErrInvalidHTTPCode = errors.New("not a valid http status code")
+
+	// ErrInvalidConcurrencyLimit returns when a non-positive concurrency limit is provided.
+	ErrInvalidConcurrencyLimit = errors.New("concurrency limit must be greater than 0")
+
+	// ErrInvalidBandwidth returns when a non-positive bytes-per-second rate is provided.
+	ErrInvalidBandwidth = errors.New("bandwidth must be greater than 0 bytes per second")
+
+	// ErrInvalidLatencyDistribution returns when a LatencyDistribution is constructed with
+	// out-of-range parameters.
+	ErrInvalidLatencyDistribution = errors.New("invalid latency distribution parameters")
+
+	// ErrInvalidRoundTripperPercent returns when a RoundTripperFault is constructed with a
+	// percent outside of [0, 1.0].
+	ErrInvalidRoundTripperPercent = errors.New("percent must be between 0 and 1.0")
 )
 
 type InjectorState int
@@ -18,6 +44,9 @@ const (
 	StateStarted InjectorState = iota + 1
 	StateFinished
 	StateSkipped
+	// StateOverflow reports that a request arrived while an injector was already saturated,
+	// distinct from the ordinary started/finished states.
+	StateOverflow
 )
 
 // Injector is an interface for our fault injection middleware. Injectors are wrapped into Faults.
@@ -143,7 +172,7 @@ func (i *RejectInjector) Name() string {
 func (i *RejectInjector) Handler(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if i != nil {
-			reportWithMessage(i.reporter, i.Name(), StateStarted)
+			reportWithRequest(i.reporter, i.Name(), StateStarted, w, r)
 		}
 
 		// This is a specialized and documented way of sending an interrupted response to
@@ -158,6 +187,20 @@ func (i *RejectInjector) SetReporter(r Reporter) {
 	i.reporter = r
 }
 
+// RoundTripper wraps next so that outbound requests are rejected the same way Handler rejects
+// inbound ones, returning a connection-level error instead of a response.
+func (i *RejectInjector) RoundTripper(next http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if i != nil {
+			reportWithMessage(i.reporter, i.Name(), StateStarted)
+
+			return nil, &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("fault: connection rejected by RejectInjector")}
+		}
+
+		return next.RoundTrip(r)
+	})
+}
+
 // ErrorInjector immediately responds with an http status code and the error message associated with
 // that code.
 type ErrorInjector struct {
@@ -189,7 +232,7 @@ func (i *ErrorInjector) Name() string {
 func (i *ErrorInjector) Handler(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if i != nil {
-			reportWithMessage(i.reporter, i.Name(), StateStarted)
+			reportWithRequest(i.reporter, i.Name(), StateStarted, w, r)
 
 			if http.StatusText(i.statusCode) != "" {
 				http.Error(w, i.statusText, i.statusCode)
@@ -205,18 +248,153 @@ func (i *ErrorInjector) SetReporter(r Reporter) {
 	i.reporter = r
 }
 
-// SlowInjector sleeps a specified duration and then continues the request. Simulates latency.
+// RoundTripper wraps next so that outbound requests receive a synthesized *http.Response with
+// the configured status code and a canned body instead of being sent.
+func (i *ErrorInjector) RoundTripper(next http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if i != nil {
+			reportWithMessage(i.reporter, i.Name(), StateStarted)
+
+			return &http.Response{
+				StatusCode: i.statusCode,
+				Status:     fmt.Sprintf("%d %s", i.statusCode, i.statusText),
+				Proto:      "HTTP/1.1",
+				ProtoMajor: 1,
+				ProtoMinor: 1,
+				Header:     make(http.Header),
+				Body:       io.NopCloser(strings.NewReader(i.statusText)),
+				Request:    r,
+			}, nil
+		}
+
+		return next.RoundTrip(r)
+	})
+}
+
+// LatencyDistribution draws a sleep duration from a probability distribution. It backs
+// SlowInjector so latency faults can model the shape of a real dependency's latency (a flat
+// value, a uniform range, a normal spread, or a heavy tail) instead of a single fixed duration.
+type LatencyDistribution interface {
+	Draw(r *rand.Rand) time.Duration
+}
+
+type constantDistribution struct {
+	d time.Duration
+}
+
+// NewConstantLatencyDistribution returns a LatencyDistribution that always draws d.
+func NewConstantLatencyDistribution(d time.Duration) LatencyDistribution {
+	return &constantDistribution{d: d}
+}
+
+func (c *constantDistribution) Draw(r *rand.Rand) time.Duration {
+	return c.d
+}
+
+type uniformDistribution struct {
+	min, max time.Duration
+}
+
+// NewUniformLatencyDistribution returns a LatencyDistribution that draws uniformly from
+// [min, max), or always min when min equals max.
+func NewUniformLatencyDistribution(min, max time.Duration) (LatencyDistribution, error) {
+	if max < min {
+		return nil, ErrInvalidLatencyDistribution
+	}
+
+	return &uniformDistribution{min: min, max: max}, nil
+}
+
+func (u *uniformDistribution) Draw(r *rand.Rand) time.Duration {
+	if u.max == u.min {
+		return u.min
+	}
+
+	return u.min + time.Duration(r.Int63n(int64(u.max-u.min)))
+}
+
+type normalDistribution struct {
+	mean, stddev time.Duration
+}
+
+// NewNormalLatencyDistribution returns a LatencyDistribution that draws from a normal
+// distribution with the given mean and standard deviation, clamped to >= 0.
+func NewNormalLatencyDistribution(mean, stddev time.Duration) LatencyDistribution {
+	return &normalDistribution{mean: mean, stddev: stddev}
+}
+
+func (n *normalDistribution) Draw(r *rand.Rand) time.Duration {
+	d := n.mean + time.Duration(r.NormFloat64()*float64(n.stddev))
+	if d < 0 {
+		return 0
+	}
+
+	return d
+}
+
+type exponentialDistribution struct {
+	lambda float64
+}
+
+// NewExponentialLatencyDistribution returns a LatencyDistribution that draws from an
+// exponential distribution with rate lambda, modeling Poisson-arrival-style tail latency.
+func NewExponentialLatencyDistribution(lambda float64) (LatencyDistribution, error) {
+	if lambda <= 0 {
+		return nil, ErrInvalidLatencyDistribution
+	}
+
+	return &exponentialDistribution{lambda: lambda}, nil
+}
+
+func (e *exponentialDistribution) Draw(r *rand.Rand) time.Duration {
+	return time.Duration(r.ExpFloat64() / e.lambda * float64(time.Second))
+}
+
+type paretoDistribution struct {
+	scale, alpha float64
+}
+
+// NewParetoLatencyDistribution returns a LatencyDistribution that draws from a Pareto
+// distribution with the given scale and alpha, for modeling heavy-tailed p99 latency spikes.
+func NewParetoLatencyDistribution(scale, alpha float64) (LatencyDistribution, error) {
+	if scale <= 0 || alpha <= 0 {
+		return nil, ErrInvalidLatencyDistribution
+	}
+
+	return &paretoDistribution{scale: scale, alpha: alpha}, nil
+}
+
+func (p *paretoDistribution) Draw(r *rand.Rand) time.Duration {
+	u := r.Float64()
+	for u == 0 {
+		u = r.Float64()
+	}
+
+	return time.Duration(p.scale / math.Pow(u, 1/p.alpha) * float64(time.Second))
+}
+
+// SlowInjector sleeps for a duration drawn from the configured LatencyDistribution and then
+// continues the request. Simulates latency.
 type SlowInjector struct {
-	duration time.Duration
-	reporter Reporter
-	sleep    func(t time.Duration)
+	distribution LatencyDistribution
+	reporter     Reporter
+	sleep        func(t time.Duration)
+	rand         *rand.Rand
 }
 
-// NewSlowInjector returns a SlowInjector that adds the configured latency.
+// NewSlowInjector returns a SlowInjector that adds the configured constant latency.
 func NewSlowInjector(d time.Duration) (*SlowInjector, error) {
+	return NewDistributionSlowInjector(NewConstantLatencyDistribution(d))
+}
+
+// NewDistributionSlowInjector returns a SlowInjector that draws its added latency from ld on
+// every request, letting callers model p50/p99/p999 latency profiles instead of a single flat
+// duration.
+func NewDistributionSlowInjector(ld LatencyDistribution) (*SlowInjector, error) {
 	return &SlowInjector{
-		duration: d,
-		sleep:    time.Sleep,
+		distribution: ld,
+		sleep:        time.Sleep,
+		rand:         rand.New(rand.NewSource(defaultRandSeed)),
 	}, nil
 }
 
@@ -225,12 +403,18 @@ func (i *SlowInjector) Name() string {
 	return "Slow Injector"
 }
 
-// Handler waits the configured duration and then continues the request.
+// SetRandSeed sets the random seed for SlowInjector to a non-default value
+func (i *SlowInjector) SetRandSeed(s int64) {
+	i.rand = rand.New(rand.NewSource(s))
+}
+
+// Handler waits for a duration drawn from the configured distribution and then continues the
+// request.
 func (i *SlowInjector) Handler(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if i != nil && i.sleep != nil {
-			reportWithMessage(i.reporter, i.Name(), StateStarted)
-			i.sleep(i.duration)
+		if i != nil && i.sleep != nil && i.distribution != nil {
+			reportWithRequest(i.reporter, i.Name(), StateStarted, w, r)
+			i.sleep(i.distribution.Draw(i.rand))
 			reportWithMessage(i.reporter, i.Name(), StateFinished)
 
 			next.ServeHTTP(w, updateRequestContextValue(r, ContextValueSlowInjector))
@@ -244,3 +428,774 @@ func (i *SlowInjector) Handler(next http.Handler) http.Handler {
 func (i *SlowInjector) SetReporter(r Reporter) {
 	i.reporter = r
 }
+
+// RoundTripper wraps next, sleeping for a duration drawn from the configured distribution
+// before delegating the outbound request.
+func (i *SlowInjector) RoundTripper(next http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if i != nil && i.sleep != nil && i.distribution != nil {
+			reportWithMessage(i.reporter, i.Name(), StateStarted)
+			i.sleep(i.distribution.Draw(i.rand))
+			reportWithMessage(i.reporter, i.Name(), StateFinished)
+		}
+
+		return next.RoundTrip(r)
+	})
+}
+
+// PanicInjector panics with a caller-supplied value. Unlike RejectInjector, which deliberately
+// panics with the special-cased http.ErrAbortHandler so that Go's HTTP server suppresses the
+// stack trace, PanicInjector produces an ordinary panic so it exercises an application's own
+// recover() middleware, 500-response wrapping, and logging pipelines the same way a real panic
+// from a nil dereference, an out-of-range index, or a third-party library would.
+type PanicInjector struct {
+	value    interface{}
+	nilDeref bool
+	reporter Reporter
+}
+
+// NewPanicInjector returns a PanicInjector that panics with the provided value.
+func NewPanicInjector(v interface{}) (*PanicInjector, error) {
+	return &PanicInjector{
+		value: v,
+	}, nil
+}
+
+// NewNilDerefPanicInjector returns a PanicInjector that triggers an actual nil pointer
+// dereference, producing a genuine runtime.Error rather than an arbitrary panic value.
+func NewNilDerefPanicInjector() (*PanicInjector, error) {
+	return &PanicInjector{
+		nilDeref: true,
+	}, nil
+}
+
+// Name returns the name of the Injector
+func (i *PanicInjector) Name() string {
+	return "Panic Injector"
+}
+
+// Handler immediately panics with the configured value, or, for an injector from
+// NewNilDerefPanicInjector, with the runtime.Error of a nil pointer dereference.
+func (i *PanicInjector) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if i != nil {
+			reportWithMessage(i.reporter, i.Name(), StateStarted)
+
+			if i.nilDeref {
+				var p *int
+				_ = *p
+			} else {
+				panic(i.value)
+			}
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// SetReporter sets the Reporter for the injector
+func (i *PanicInjector) SetReporter(r Reporter) {
+	i.reporter = r
+}
+
+// ConcurrencyLimitInjector admits at most a fixed number of in-flight requests through the
+// wrapped handler, simulating a saturated worker pool or connection limit. Requests that arrive
+// once the limit is reached wait up to maxWait for a slot to free up before falling through to
+// overflow; a maxWait of 0 treats the limit as a hard cap and overflows immediately.
+type ConcurrencyLimitInjector struct {
+	limit    int
+	maxWait  time.Duration
+	overflow Injector
+	sem      chan struct{}
+	reporter Reporter
+}
+
+// NewConcurrencyLimitInjector returns a ConcurrencyLimitInjector that allows at most limit
+// requests to be in-flight through next at once. overflow runs for requests that exceed the
+// limit and exhaust maxWait; overflow may be nil, in which case those requests continue to next
+// unmodified.
+func NewConcurrencyLimitInjector(limit int, maxWait time.Duration, overflow Injector) (*ConcurrencyLimitInjector, error) {
+	if limit <= 0 {
+		return nil, ErrInvalidConcurrencyLimit
+	}
+
+	return &ConcurrencyLimitInjector{
+		limit:    limit,
+		maxWait:  maxWait,
+		overflow: overflow,
+		sem:      make(chan struct{}, limit),
+	}, nil
+}
+
+// Name returns the name of the Injector
+func (i *ConcurrencyLimitInjector) Name() string {
+	return "Concurrency Limit Injector"
+}
+
+// Handler admits the request if fewer than limit requests are in-flight, waits up to maxWait
+// for a slot to open, and otherwise hands off to overflow.
+func (i *ConcurrencyLimitInjector) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if i == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		reportWithMessage(i.reporter, i.Name(), StateStarted)
+
+		acquired := false
+		select {
+		case i.sem <- struct{}{}:
+			acquired = true
+		default:
+			if i.maxWait > 0 {
+				timer := time.NewTimer(i.maxWait)
+				defer timer.Stop()
+
+				select {
+				case i.sem <- struct{}{}:
+					acquired = true
+				case <-timer.C:
+				case <-r.Context().Done():
+				}
+			}
+		}
+
+		if acquired {
+			defer func() { <-i.sem }()
+			next.ServeHTTP(w, updateRequestContextValue(r, ContextValueConcurrencyLimitInjector))
+			reportWithMessage(i.reporter, i.Name(), StateFinished)
+			return
+		}
+
+		reportWithMessage(i.reporter, i.Name(), StateOverflow)
+
+		if i.overflow != nil {
+			i.overflow.Handler(next).ServeHTTP(w, r)
+			return
+		}
+
+		next.ServeHTTP(w, updateRequestContextValue(r, ContextValueSkipped))
+	})
+}
+
+// SetReporter sets the Reporter for the injector
+func (i *ConcurrencyLimitInjector) SetReporter(r Reporter) {
+	i.reporter = r
+}
+
+// BandwidthInjector wraps the ResponseWriter and rate-limits body writes to a configured
+// bytes-per-second, simulating a slow network connection to the client. Unlike SlowInjector,
+// which pauses once before the handler runs, BandwidthInjector throttles every Write the
+// downstream handler makes, so streaming responses such as SSE, chunked JSON, or large
+// downloads are throttled realistically.
+type BandwidthInjector struct {
+	bytesPerSecond int
+	initialDelay   time.Duration
+	chunkJitter    time.Duration
+	reporter       Reporter
+	sleep          func(t time.Duration)
+	rand           *rand.Rand
+}
+
+// NewBandwidthInjector returns a BandwidthInjector that paces response body writes to
+// bytesPerSecond. initialDelay, if set, is an additional one-time delay before the first byte is
+// written, separate from the ongoing throughput cap. chunkJitter, if set, adds a random amount
+// of extra delay between throttled writes.
+func NewBandwidthInjector(bytesPerSecond int, initialDelay time.Duration, chunkJitter time.Duration) (*BandwidthInjector, error) {
+	if bytesPerSecond <= 0 {
+		return nil, ErrInvalidBandwidth
+	}
+
+	return &BandwidthInjector{
+		bytesPerSecond: bytesPerSecond,
+		initialDelay:   initialDelay,
+		chunkJitter:    chunkJitter,
+		sleep:          time.Sleep,
+		rand:           rand.New(rand.NewSource(defaultRandSeed)),
+	}, nil
+}
+
+// Name returns the name of the Injector
+func (i *BandwidthInjector) Name() string {
+	return "Bandwidth Injector"
+}
+
+// SetRandSeed sets the random seed for BandwidthInjector to a non-default value
+func (i *BandwidthInjector) SetRandSeed(s int64) {
+	i.rand = rand.New(rand.NewSource(s))
+}
+
+// Handler wraps w in a bandwidthResponseWriter so that next's body writes are rate-limited to
+// the configured bytes-per-second.
+func (i *BandwidthInjector) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if i == nil || i.sleep == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		reportWithMessage(i.reporter, i.Name(), StateStarted)
+
+		bw := newBandwidthResponseWriter(w, r.Context(), i)
+		next.ServeHTTP(bw, updateRequestContextValue(r, ContextValueBandwidthInjector))
+
+		reportWithMessage(i.reporter, i.Name(), StateFinished)
+	})
+}
+
+// SetReporter sets the Reporter for the injector
+func (i *BandwidthInjector) SetReporter(r Reporter) {
+	i.reporter = r
+}
+
+// bandwidthResponseWriter wraps an http.ResponseWriter and paces Write calls to a token-bucket
+// sized for the configured bytes-per-second, aborting early if the request context is canceled.
+type bandwidthResponseWriter struct {
+	http.ResponseWriter
+	ctx context.Context
+	i   *BandwidthInjector
+	rnd *rand.Rand
+
+	tokens     float64
+	lastRefill time.Time
+	wroteAny   bool
+}
+
+func newBandwidthResponseWriter(w http.ResponseWriter, ctx context.Context, i *BandwidthInjector) *bandwidthResponseWriter {
+	return &bandwidthResponseWriter{
+		ResponseWriter: w,
+		ctx:            ctx,
+		i:              i,
+		rnd:            i.rand,
+		tokens:         float64(i.bytesPerSecond),
+		lastRefill:     time.Now(),
+	}
+}
+
+// Write throttles p to the configured bytes-per-second, sleeping between chunks as the token
+// bucket empties, and returns early if the request context is canceled, including mid-sleep.
+func (w *bandwidthResponseWriter) Write(p []byte) (int, error) {
+	if !w.wroteAny {
+		w.wroteAny = true
+		if w.i.initialDelay > 0 && !w.sleepCtx(w.i.initialDelay) {
+			return 0, w.ctx.Err()
+		}
+	}
+
+	written := 0
+	for len(p) > 0 {
+		if w.ctx.Err() != nil {
+			return written, w.ctx.Err()
+		}
+
+		now := time.Now()
+		w.tokens += now.Sub(w.lastRefill).Seconds() * float64(w.i.bytesPerSecond)
+		if w.tokens > float64(w.i.bytesPerSecond) {
+			w.tokens = float64(w.i.bytesPerSecond)
+		}
+		w.lastRefill = now
+
+		if w.tokens < 1 {
+			wait := time.Duration(float64(time.Second) / float64(w.i.bytesPerSecond))
+			if w.i.chunkJitter > 0 {
+				wait += time.Duration(w.rnd.Int63n(int64(w.i.chunkJitter)))
+			}
+			if !w.sleepCtx(wait) {
+				return written, w.ctx.Err()
+			}
+			continue
+		}
+
+		chunk := int(w.tokens)
+		if chunk > len(p) {
+			chunk = len(p)
+		}
+
+		n, err := w.ResponseWriter.Write(p[:chunk])
+		written += n
+		w.tokens -= float64(n)
+		p = p[chunk:]
+		if err != nil {
+			return written, err
+		}
+	}
+
+	return written, nil
+}
+
+// sleepCtx sleeps for d using w.i.sleep, returning early with false if w.ctx is canceled before
+// d elapses so a canceled request doesn't sit blocked for the full sleep duration.
+func (w *bandwidthResponseWriter) sleepCtx(d time.Duration) bool {
+	if d <= 0 {
+		return true
+	}
+
+	done := make(chan struct{})
+	go func() {
+		w.i.sleep(d)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return true
+	case <-w.ctx.Done():
+		return false
+	}
+}
+
+// Flush implements http.Flusher by delegating to the wrapped ResponseWriter, if it supports it.
+func (w *bandwidthResponseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Hijack implements http.Hijacker by delegating to the wrapped ResponseWriter, if it supports it.
+func (w *bandwidthResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("fault: underlying ResponseWriter does not support http.Hijacker")
+	}
+
+	return h.Hijack()
+}
+
+// Push implements http.Pusher by delegating to the wrapped ResponseWriter, if it supports it.
+func (w *bandwidthResponseWriter) Push(target string, opts *http.PushOptions) error {
+	p, ok := w.ResponseWriter.(http.Pusher)
+	if !ok {
+		return http.ErrNotSupported
+	}
+
+	return p.Push(target, opts)
+}
+
+// RoundTripperInjector is implemented by injectors that can additionally wrap an
+// http.RoundTripper, letting faults be injected into outbound requests made through an
+// http.Client.Transport instead of only into inbound http.Handler traffic.
+type RoundTripperInjector interface {
+	RoundTripper(next http.RoundTripper) http.RoundTripper
+}
+
+// roundTripperFunc adapts an ordinary function to the http.RoundTripper interface, mirroring
+// http.HandlerFunc.
+type roundTripperFunc func(r *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+// ConnectionResetInjector simulates a dependency resetting the connection mid-request. It has
+// no server-side effect; it only implements RoundTripperInjector for use against an outbound
+// http.Client.
+type ConnectionResetInjector struct {
+	reporter Reporter
+}
+
+// NewConnectionResetInjector returns a ConnectionResetInjector.
+func NewConnectionResetInjector() (*ConnectionResetInjector, error) {
+	return &ConnectionResetInjector{}, nil
+}
+
+// Name returns the name of the Injector
+func (i *ConnectionResetInjector) Name() string {
+	return "Connection Reset Injector"
+}
+
+// SetReporter sets the Reporter for the injector
+func (i *ConnectionResetInjector) SetReporter(r Reporter) {
+	i.reporter = r
+}
+
+// RoundTripper wraps next so that outbound requests fail with a connection-reset error instead
+// of being sent.
+func (i *ConnectionResetInjector) RoundTripper(next http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if i != nil {
+			reportWithMessage(i.reporter, i.Name(), StateStarted)
+
+			return nil, &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}
+		}
+
+		return next.RoundTrip(r)
+	})
+}
+
+// ChainRoundTripper combines many RoundTripperInjectors into a single http.RoundTripper. Each
+// injector's RoundTripper() wraps next in the order provided, mirroring ChainInjector on the
+// client side.
+type ChainRoundTripper struct {
+	middlewares []func(next http.RoundTripper) http.RoundTripper
+}
+
+// NewChainRoundTripper combines many RoundTripperInjectors into a single ChainRoundTripper. The
+// RoundTripper() for each injector will execute in the order provided.
+func NewChainRoundTripper(is ...RoundTripperInjector) (*ChainRoundTripper, error) {
+	chainRoundTripper := &ChainRoundTripper{}
+	for _, i := range is {
+		chainRoundTripper.middlewares = append(chainRoundTripper.middlewares, i.RoundTripper)
+	}
+
+	return chainRoundTripper, nil
+}
+
+// RoundTripper executes ChainRoundTripper.middlewares in order and then returns.
+func (c *ChainRoundTripper) RoundTripper(next http.RoundTripper) http.RoundTripper {
+	if c != nil {
+		// Loop in reverse to preserve RoundTripper order.
+		for idx := len(c.middlewares) - 1; idx >= 0; idx-- {
+			next = c.middlewares[idx](next)
+		}
+	}
+
+	return next
+}
+
+// RandomRoundTripper combines many RoundTripperInjectors into a single http.RoundTripper. On
+// each outbound request it randomly runs one of the provided injectors, mirroring
+// RandomInjector on the client side.
+type RandomRoundTripper struct {
+	middlewares []func(next http.RoundTripper) http.RoundTripper
+
+	rand  *rand.Rand
+	randF func(int) int
+}
+
+// NewRandomRoundTripper combines many RoundTripperInjectors into a single RandomRoundTripper.
+// On each outbound request it randomly runs one of the provided injectors.
+func NewRandomRoundTripper(is ...RoundTripperInjector) (*RandomRoundTripper, error) {
+	randomRoundTripper := &RandomRoundTripper{}
+
+	randomRoundTripper.rand = rand.New(rand.NewSource(defaultRandSeed))
+	randomRoundTripper.randF = randomRoundTripper.rand.Intn
+
+	for _, i := range is {
+		randomRoundTripper.middlewares = append(randomRoundTripper.middlewares, i.RoundTripper)
+	}
+
+	return randomRoundTripper, nil
+}
+
+// SetRandSeed sets the random seed for RandomRoundTripper to a non-default value
+func (c *RandomRoundTripper) SetRandSeed(s int64) {
+	c.rand = rand.New(rand.NewSource(s))
+	c.randF = c.rand.Intn
+}
+
+// RoundTripper wraps next so that a random RoundTripperInjector from RandomRoundTripper.middlewares
+// is chosen on every outbound request, rather than once when RoundTripper is called.
+func (c *RandomRoundTripper) RoundTripper(next http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if c == nil || len(c.middlewares) == 0 {
+			return next.RoundTrip(r)
+		}
+
+		return c.middlewares[c.randF(len(c.middlewares))](next).RoundTrip(r)
+	})
+}
+
+// RoundTripperFault wraps a RoundTripperInjector and decides, per outbound request, whether to
+// invoke it. It mirrors Fault's percent-based triggering on the server side, and additionally
+// supports excluding specific request paths since a RoundTripper has no access to Fault's
+// request-scoped options by the time it runs.
+type RoundTripperFault struct {
+	injector      RoundTripperInjector
+	enabled       bool
+	percent       float32
+	pathBlocklist map[string]bool
+
+	rand  *rand.Rand
+	randF func() float32
+}
+
+// RoundTripperFaultOption configures a RoundTripperFault at construction time.
+type RoundTripperFaultOption func(*RoundTripperFault)
+
+// WithRoundTripperEnabled sets whether the RoundTripperFault is evaluated at all. Defaults to
+// true.
+func WithRoundTripperEnabled(e bool) RoundTripperFaultOption {
+	return func(f *RoundTripperFault) {
+		f.enabled = e
+	}
+}
+
+// WithRoundTripperPercent sets what percent, [0, 1.0], of requests have the fault injected.
+// Defaults to 1.0.
+func WithRoundTripperPercent(p float32) RoundTripperFaultOption {
+	return func(f *RoundTripperFault) {
+		f.percent = p
+	}
+}
+
+// WithRoundTripperPathBlocklist excludes requests to the given URL paths from fault injection,
+// regardless of percent.
+func WithRoundTripperPathBlocklist(paths ...string) RoundTripperFaultOption {
+	return func(f *RoundTripperFault) {
+		f.pathBlocklist = make(map[string]bool, len(paths))
+		for _, p := range paths {
+			f.pathBlocklist[p] = true
+		}
+	}
+}
+
+// NewRoundTripperFault returns a RoundTripperFault that injects i the configured percent of the
+// time.
+func NewRoundTripperFault(i RoundTripperInjector, opts ...RoundTripperFaultOption) (*RoundTripperFault, error) {
+	f := &RoundTripperFault{
+		injector: i,
+		enabled:  true,
+		percent:  1,
+		rand:     rand.New(rand.NewSource(defaultRandSeed)),
+	}
+	f.randF = f.rand.Float32
+
+	for _, opt := range opts {
+		opt(f)
+	}
+
+	if f.percent < 0 || f.percent > 1 {
+		return nil, ErrInvalidRoundTripperPercent
+	}
+
+	return f, nil
+}
+
+// SetRandSeed sets the random seed for RoundTripperFault to a non-default value
+func (f *RoundTripperFault) SetRandSeed(s int64) {
+	f.rand = rand.New(rand.NewSource(s))
+	f.randF = f.rand.Float32
+}
+
+// RoundTripper wraps next, invoking the configured RoundTripperInjector the configured percent
+// of the time, skipping any request whose URL path is in the blocklist.
+func (f *RoundTripperFault) RoundTripper(next http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if f == nil || f.injector == nil || !f.enabled {
+			return next.RoundTrip(r)
+		}
+
+		if f.pathBlocklist[r.URL.Path] {
+			return next.RoundTrip(r)
+		}
+
+		if f.randF() < f.percent {
+			return f.injector.RoundTripper(next).RoundTrip(r)
+		}
+
+		return next.RoundTrip(r)
+	})
+}
+
+// RequestReporter is an optional extension to Reporter. An injector that wants to attach a
+// request snapshot to a reported state asserts for this interface via reportWithRequest, so
+// existing Reporter implementations that don't care about the extra detail are unaffected.
+type RequestReporter interface {
+	Reporter
+	ReportRequest(name string, state InjectorState, dump []byte)
+}
+
+// reportWithRequest reports name and state the same way reportWithMessage does, and if reporter
+// also implements RequestReporter, additionally attaches a snapshot of r in
+// httputil.DumpRequest's wire format so operators can correlate an injected fault with the
+// exact request that triggered it.
+func reportWithRequest(reporter Reporter, name string, state InjectorState, w http.ResponseWriter, r *http.Request) {
+	reportWithMessage(reporter, name, state)
+
+	rr, ok := reporter.(RequestReporter)
+	if !ok {
+		return
+	}
+
+	dump, err := dumpRequest(w, r)
+	if err != nil {
+		return
+	}
+
+	rr.ReportRequest(name, state, dump)
+}
+
+// dumpRequestBodyLimit caps how many bytes of a request body dumpRequest reads into a snapshot.
+// Without a cap, a large or slow-streaming body would be read in full before RejectInjector's
+// abort or ErrorInjector/SlowInjector's forwarding, turning a Reporter into an unbounded read of
+// client-controlled data.
+const dumpRequestBodyLimit = 64 * 1024
+
+// dumpRequestReadTimeout bounds how long dumpRequest will wait on a slow or stalled request body
+// before giving up on capturing more of it. Bounding the byte count alone isn't enough: a client
+// that trickles its body in below dumpRequestBodyLimit, or never finishes sending it, would
+// otherwise block RejectInjector's and ErrorInjector's fast-fail response for as long as it
+// likes. Hitting this timeout only truncates the snapshot handed to the Reporter early; it never
+// discards bytes from the request body itself; r.Body, once restored, always still presents
+// every byte the client sends, in order, to whatever handler runs afterward (SlowInjector, for
+// example, forwards the same r.Body to next after the snapshot is taken).
+const dumpRequestReadTimeout = 200 * time.Millisecond
+
+// dumpRequest behaves like httputil.DumpRequest(r, true), except the body it captures is capped
+// at dumpRequestBodyLimit bytes and the time spent waiting on it is capped at
+// dumpRequestReadTimeout. r.Body is restored to its original content (the captured prefix
+// followed by whatever was left unread, with nothing skipped or duplicated) so any handler that
+// runs afterward still sees the exact same body the client sent.
+func dumpRequest(w http.ResponseWriter, r *http.Request) ([]byte, error) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return httputil.DumpRequest(r, false)
+	}
+
+	captured, truncated, err := readBodySnapshot(w, r, dumpRequestBodyLimit, dumpRequestReadTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	dump, err := httputil.DumpRequest(r, false)
+	if err != nil {
+		return nil, err
+	}
+
+	dump = append(dump, captured...)
+	if truncated {
+		dump = append(dump, []byte("...(truncated)")...)
+	}
+
+	return dump, nil
+}
+
+// readBodySnapshot reads up to limit bytes of r.Body for a snapshot and restores r.Body to the
+// exact bytes read followed by whatever is left unread, so no byte is ever lost or duplicated.
+//
+// The read is bounded by timeout using w's http.ResponseController, which (per net/http's
+// documented SetReadDeadline behavior) makes r.Body.Read return an error satisfying
+// errors.Is(err, os.ErrDeadlineExceeded) once the deadline passes, without closing or otherwise
+// disturbing the connection. That's treated as an ordinary truncation, not a failure: whatever
+// was read before the deadline becomes the (truncated) snapshot, and the deadline is cleared
+// before returning so the real handler's later reads of r.Body are not affected. If w doesn't
+// support SetReadDeadline (for example a ResponseWriter used only in tests), the read proceeds
+// unbounded by time, limited only by limit.
+func readBodySnapshot(w http.ResponseWriter, r *http.Request, limit int, timeout time.Duration) ([]byte, bool, error) {
+	rc := http.NewResponseController(w)
+	if timeout > 0 && rc.SetReadDeadline(time.Now().Add(timeout)) == nil {
+		defer rc.SetReadDeadline(time.Time{})
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r.Body, int64(limit)+1))
+	timedOut := errors.Is(err, os.ErrDeadlineExceeded)
+	if err != nil && !timedOut {
+		return nil, false, err
+	}
+
+	truncated := timedOut || len(data) > limit
+
+	r.Body = struct {
+		io.Reader
+		io.Closer
+	}{
+		Reader: io.MultiReader(bytes.NewReader(data), r.Body),
+		Closer: r.Body,
+	}
+
+	snapshot := data
+	if len(snapshot) > limit {
+		snapshot = snapshot[:limit]
+	}
+
+	return snapshot, truncated, nil
+}
+
+// RequestDump is a single request snapshot captured by DumpReporter.
+type RequestDump struct {
+	Name  string
+	State InjectorState
+	Dump  []byte
+}
+
+// DumpReporter is a default RequestReporter that captures the request snapshots attached by
+// reportWithRequest, redacting configured headers and capping the body so that fault injection
+// stays correlatable with the traffic that triggered it without leaking sensitive data.
+type DumpReporter struct {
+	maxBodyBytes    int
+	redactedHeaders map[string]bool
+
+	mu    sync.Mutex
+	dumps []RequestDump
+}
+
+// NewDumpReporter returns a DumpReporter that redacts the given headers (case-insensitive) and
+// caps captured bodies to maxBodyBytes. A maxBodyBytes of 0 means no cap. If redactHeaders is
+// empty, Authorization and Cookie are redacted by default.
+func NewDumpReporter(maxBodyBytes int, redactHeaders ...string) *DumpReporter {
+	if len(redactHeaders) == 0 {
+		redactHeaders = []string{"Authorization", "Cookie"}
+	}
+
+	redacted := make(map[string]bool, len(redactHeaders))
+	for _, h := range redactHeaders {
+		redacted[http.CanonicalHeaderKey(h)] = true
+	}
+
+	return &DumpReporter{
+		maxBodyBytes:    maxBodyBytes,
+		redactedHeaders: redacted,
+	}
+}
+
+// Report implements Reporter. DumpReporter only records the richer dumps delivered through
+// ReportRequest, so plain Report calls are a no-op.
+func (d *DumpReporter) Report(name string, state InjectorState) {}
+
+// ReportRequest implements RequestReporter, redacting configured headers and capping the body
+// before storing the dump.
+func (d *DumpReporter) ReportRequest(name string, state InjectorState, dump []byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.dumps = append(d.dumps, RequestDump{
+		Name:  name,
+		State: state,
+		Dump:  d.redact(dump),
+	})
+}
+
+// Dumps returns the request snapshots captured so far.
+func (d *DumpReporter) Dumps() []RequestDump {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	dumps := make([]RequestDump, len(d.dumps))
+	copy(dumps, d.dumps)
+
+	return dumps
+}
+
+// redact blanks configured header values and caps the body of an httputil.DumpRequest-style
+// dump.
+func (d *DumpReporter) redact(dump []byte) []byte {
+	lines := strings.Split(string(dump), "\r\n")
+
+	bodyStart := len(lines)
+	for idx, line := range lines {
+		if line == "" {
+			bodyStart = idx + 1
+			break
+		}
+
+		colon := strings.IndexByte(line, ':')
+		if colon < 0 {
+			continue
+		}
+
+		key := http.CanonicalHeaderKey(strings.TrimSpace(line[:colon]))
+		if d.redactedHeaders[key] {
+			lines[idx] = key + ": REDACTED"
+		}
+	}
+
+	if bodyStart < len(lines) {
+		body := strings.Join(lines[bodyStart:], "\r\n")
+		if d.maxBodyBytes > 0 && len(body) > d.maxBodyBytes {
+			body = body[:d.maxBodyBytes] + "...(truncated)"
+		}
+		lines = append(lines[:bodyStart], body)
+	}
+
+	return []byte(strings.Join(lines, "\r\n"))
+}
@@ -0,0 +1,840 @@
+package fault
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestRandomRoundTripper_SelectsPerRequest(t *testing.T) {
+	i1, err := NewErrorInjector(http.StatusInternalServerError)
+	if err != nil {
+		t.Fatalf("NewErrorInjector: %v", err)
+	}
+
+	i2, err := NewErrorInjector(http.StatusServiceUnavailable)
+	if err != nil {
+		t.Fatalf("NewErrorInjector: %v", err)
+	}
+
+	rrt, err := NewRandomRoundTripper(i1, i2)
+	if err != nil {
+		t.Fatalf("NewRandomRoundTripper: %v", err)
+	}
+
+	next := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		t.Fatal("next should never be called, both injectors are terminal")
+		return nil, nil
+	})
+
+	// Wrap once and reuse the same transport for every call, mirroring how a client.Transport
+	// is assigned once and reused for every outbound request.
+	transport := rrt.RoundTripper(next)
+
+	seen := make(map[int]bool)
+	for i := 0; i < 20; i++ {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		resp, err := transport.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("RoundTrip: %v", err)
+		}
+		seen[resp.StatusCode] = true
+	}
+
+	if len(seen) < 2 {
+		t.Fatalf("expected both injectors to be selected across repeated calls on the same transport, got status codes %v", seen)
+	}
+}
+
+func TestChainRoundTripper_OrderPreserved(t *testing.T) {
+	var order []string
+
+	first := recordingRoundTripperInjector{name: "first", order: &order}
+	second := recordingRoundTripperInjector{name: "second", order: &order}
+
+	crt, err := NewChainRoundTripper(&first, &second)
+	if err != nil {
+		t.Fatalf("NewChainRoundTripper: %v", err)
+	}
+
+	next := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: r}, nil
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+	if _, err := crt.RoundTripper(next).RoundTrip(req); err != nil {
+		t.Fatalf("RoundTrip: %v", err)
+	}
+
+	want := []string{"first", "second"}
+	if len(order) != len(want) || order[0] != want[0] || order[1] != want[1] {
+		t.Fatalf("got call order %v, want %v", order, want)
+	}
+}
+
+type recordingRoundTripperInjector struct {
+	name  string
+	order *[]string
+}
+
+func (r *recordingRoundTripperInjector) RoundTripper(next http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		*r.order = append(*r.order, r.name)
+		return next.RoundTrip(req)
+	})
+}
+
+func TestRoundTripperFault_Percent(t *testing.T) {
+	injected := NewErrorInjectorRoundTripper(t)
+
+	f, err := NewRoundTripperFault(injected, WithRoundTripperPercent(0))
+	if err != nil {
+		t.Fatalf("NewRoundTripperFault: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+	resp, err := f.RoundTripper(passthroughRoundTripper()).RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("percent 0 should never inject, got status %d", resp.StatusCode)
+	}
+
+	f, err = NewRoundTripperFault(injected, WithRoundTripperPercent(1))
+	if err != nil {
+		t.Fatalf("NewRoundTripperFault: %v", err)
+	}
+
+	resp, err = f.RoundTripper(passthroughRoundTripper()).RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip: %v", err)
+	}
+	if resp.StatusCode != http.StatusTeapot {
+		t.Fatalf("percent 1 should always inject, got status %d", resp.StatusCode)
+	}
+}
+
+func TestRoundTripperFault_PathBlocklist(t *testing.T) {
+	injected := NewErrorInjectorRoundTripper(t)
+
+	f, err := NewRoundTripperFault(injected, WithRoundTripperPercent(1), WithRoundTripperPathBlocklist("/health"))
+	if err != nil {
+		t.Fatalf("NewRoundTripperFault: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "http://example.com/health", nil)
+	resp, err := f.RoundTripper(passthroughRoundTripper()).RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("blocklisted path should skip injection, got status %d", resp.StatusCode)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "http://example.com/other", nil)
+	resp, err = f.RoundTripper(passthroughRoundTripper()).RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip: %v", err)
+	}
+	if resp.StatusCode != http.StatusTeapot {
+		t.Fatalf("non-blocklisted path should be injected, got status %d", resp.StatusCode)
+	}
+}
+
+// NewErrorInjectorRoundTripper returns a RoundTripperInjector that always responds with
+// http.StatusTeapot, used as a recognizable marker for the fault-injected path in tests.
+func NewErrorInjectorRoundTripper(t *testing.T) RoundTripperInjector {
+	t.Helper()
+
+	i, err := NewErrorInjector(http.StatusTeapot)
+	if err != nil {
+		t.Fatalf("NewErrorInjector: %v", err)
+	}
+
+	return i
+}
+
+func passthroughRoundTripper() http.RoundTripper {
+	return roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: r}, nil
+	})
+}
+
+func TestConnectionResetInjector_RoundTripperReturnsECONNRESET(t *testing.T) {
+	i, err := NewConnectionResetInjector()
+	if err != nil {
+		t.Fatalf("NewConnectionResetInjector: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+	_, err = i.RoundTripper(passthroughRoundTripper()).RoundTrip(req)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	if !errors.Is(err, syscall.ECONNRESET) {
+		t.Fatalf("expected an error wrapping syscall.ECONNRESET, got %v", err)
+	}
+}
+
+func TestRejectInjector_RoundTripperReturnsConnectionError(t *testing.T) {
+	i, err := NewRejectInjector()
+	if err != nil {
+		t.Fatalf("NewRejectInjector: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+	_, err = i.RoundTripper(passthroughRoundTripper()).RoundTrip(req)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	var opErr *net.OpError
+	if !errors.As(err, &opErr) {
+		t.Fatalf("expected a *net.OpError, got %T: %v", err, err)
+	}
+}
+
+func TestSlowInjector_RoundTripperSleepsBeforeDelegating(t *testing.T) {
+	i, err := NewDistributionSlowInjector(NewConstantLatencyDistribution(42 * time.Millisecond))
+	if err != nil {
+		t.Fatalf("NewDistributionSlowInjector: %v", err)
+	}
+
+	var slept time.Duration
+	i.sleep = func(d time.Duration) { slept = d }
+
+	called := false
+	next := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		called = true
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: r}, nil
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+	if _, err := i.RoundTripper(next).RoundTrip(req); err != nil {
+		t.Fatalf("RoundTrip: %v", err)
+	}
+
+	if slept != 42*time.Millisecond {
+		t.Fatalf("got sleep %v, want 42ms", slept)
+	}
+	if !called {
+		t.Fatal("expected next to be called after the sleep")
+	}
+}
+
+func TestDumpRequest_TruncationAtExactLimit(t *testing.T) {
+	body := strings.Repeat("a", dumpRequestBodyLimit)
+	req := httptest.NewRequest(http.MethodPost, "http://example.com", strings.NewReader(body))
+
+	dump, err := dumpRequest(httptest.NewRecorder(), req)
+	if err != nil {
+		t.Fatalf("dumpRequest: %v", err)
+	}
+
+	if strings.Contains(string(dump), "truncated") {
+		t.Fatalf("body of exactly dumpRequestBodyLimit bytes should not be marked truncated")
+	}
+
+	remaining, err := io.ReadAll(req.Body)
+	if err != nil {
+		t.Fatalf("reading restored body: %v", err)
+	}
+	if string(remaining) != body {
+		t.Fatalf("restored body does not match original, got %d bytes want %d", len(remaining), len(body))
+	}
+}
+
+func TestDumpRequest_TruncationOverLimit(t *testing.T) {
+	body := strings.Repeat("a", dumpRequestBodyLimit+100)
+	req := httptest.NewRequest(http.MethodPost, "http://example.com", strings.NewReader(body))
+
+	dump, err := dumpRequest(httptest.NewRecorder(), req)
+	if err != nil {
+		t.Fatalf("dumpRequest: %v", err)
+	}
+
+	if !strings.Contains(string(dump), "truncated") {
+		t.Fatalf("body over dumpRequestBodyLimit should be marked truncated")
+	}
+
+	remaining, err := io.ReadAll(req.Body)
+	if err != nil {
+		t.Fatalf("reading restored body: %v", err)
+	}
+	if string(remaining) != body {
+		t.Fatalf("restored body does not match original, got %d bytes want %d", len(remaining), len(body))
+	}
+}
+
+// deadlineAwareReadCloser is a test double for a request body whose Read honors a read deadline
+// the way a real connection-backed http.Request.Body does: once the deadline passes, a pending
+// or future Read returns an error satisfying errors.Is(err, os.ErrDeadlineExceeded) instead of
+// blocking forever.
+type deadlineAwareReadCloser struct {
+	mu       sync.Mutex
+	deadline time.Time
+	done     chan struct{}
+}
+
+func (d *deadlineAwareReadCloser) SetReadDeadline(t time.Time) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.deadline = t
+	return nil
+}
+
+func (d *deadlineAwareReadCloser) Read(p []byte) (int, error) {
+	for {
+		d.mu.Lock()
+		deadline := d.deadline
+		d.mu.Unlock()
+
+		if !deadline.IsZero() && time.Now().After(deadline) {
+			return 0, os.ErrDeadlineExceeded
+		}
+
+		select {
+		case <-d.done:
+			return 0, io.EOF
+		case <-time.After(5 * time.Millisecond):
+		}
+	}
+}
+
+func (d *deadlineAwareReadCloser) Close() error {
+	return nil
+}
+
+// deadlineResponseWriter is a test double for an http.ResponseWriter whose SetReadDeadline
+// forwards to the request body, mirroring how http.ResponseController.SetReadDeadline bounds
+// reads of the real request body in production.
+type deadlineResponseWriter struct {
+	http.ResponseWriter
+	body *deadlineAwareReadCloser
+}
+
+func (w *deadlineResponseWriter) SetReadDeadline(t time.Time) error {
+	return w.body.SetReadDeadline(t)
+}
+
+func TestDumpRequest_SlowBodyDoesNotBlockOrLoseData(t *testing.T) {
+	body := &deadlineAwareReadCloser{done: make(chan struct{})}
+
+	req := httptest.NewRequest(http.MethodPost, "http://example.com", nil)
+	req.Body = body
+	w := &deadlineResponseWriter{ResponseWriter: httptest.NewRecorder(), body: body}
+
+	start := time.Now()
+	dump, err := dumpRequest(w, req)
+	elapsed := time.Since(start)
+
+	if err != nil {
+		t.Fatalf("dumpRequest: %v", err)
+	}
+	if elapsed > dumpRequestReadTimeout+500*time.Millisecond {
+		t.Fatalf("dumpRequest blocked for %s on a stalled body, want roughly dumpRequestReadTimeout (%s)", elapsed, dumpRequestReadTimeout)
+	}
+	if !strings.Contains(string(dump), "truncated") {
+		t.Fatalf("expected the snapshot to be marked truncated when the read deadline is hit")
+	}
+
+	// The restored body must still be readable by a handler that runs afterward: unblock the
+	// body and confirm that reading it to EOF succeeds instead of failing on the expired deadline.
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		body.mu.Lock()
+		body.deadline = time.Time{}
+		body.mu.Unlock()
+		close(body.done)
+	}()
+
+	if _, err := io.ReadAll(req.Body); err != nil {
+		t.Fatalf("reading remainder of body after deadline: %v", err)
+	}
+}
+
+func TestDumpReporter_RedactsConfiguredHeaders(t *testing.T) {
+	d := NewDumpReporter(0, "Authorization")
+
+	dump := []byte("GET / HTTP/1.1\r\nAuthorization: secret-token\r\nX-Other: keep-me\r\n\r\nbody")
+	redacted := d.redact(dump)
+
+	if strings.Contains(string(redacted), "secret-token") {
+		t.Fatalf("expected Authorization header value to be redacted, got %q", redacted)
+	}
+	if !strings.Contains(string(redacted), "X-Other: keep-me") {
+		t.Fatalf("expected non-redacted header to be preserved, got %q", redacted)
+	}
+}
+
+func TestDumpReporter_CapsBody(t *testing.T) {
+	d := NewDumpReporter(4)
+
+	dump := []byte("GET / HTTP/1.1\r\n\r\n1234567890")
+	redacted := d.redact(dump)
+
+	if !strings.Contains(string(redacted), "1234...(truncated)") {
+		t.Fatalf("expected body capped to maxBodyBytes with a truncation marker, got %q", redacted)
+	}
+}
+
+func TestPanicInjector_PanicsWithConfiguredValue(t *testing.T) {
+	i, err := NewPanicInjector("boom")
+	if err != nil {
+		t.Fatalf("NewPanicInjector: %v", err)
+	}
+
+	rep := &testReporter{}
+	i.SetReporter(rep)
+
+	defer func() {
+		if r := recover(); r != "boom" {
+			t.Fatalf("expected panic value %q, got %v", "boom", r)
+		}
+		if got := rep.count(i.Name(), StateStarted); got != 1 {
+			t.Fatalf("expected StateStarted to be reported once before the panic, got %d", got)
+		}
+	}()
+
+	req := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+	i.Handler(passthroughHandler(t)).ServeHTTP(httptest.NewRecorder(), req)
+
+	t.Fatal("expected Handler to panic")
+}
+
+func TestPanicInjector_NilDerefProducesRuntimeError(t *testing.T) {
+	i, err := NewNilDerefPanicInjector()
+	if err != nil {
+		t.Fatalf("NewNilDerefPanicInjector: %v", err)
+	}
+
+	rep := &testReporter{}
+	i.SetReporter(rep)
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatal("expected a panic")
+		}
+		if _, ok := r.(runtime.Error); !ok {
+			t.Fatalf("expected a runtime.Error from a nil dereference, got %T: %v", r, r)
+		}
+		if got := rep.count(i.Name(), StateStarted); got != 1 {
+			t.Fatalf("expected StateStarted to be reported once before the panic, got %d", got)
+		}
+	}()
+
+	req := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+	i.Handler(passthroughHandler(t)).ServeHTTP(httptest.NewRecorder(), req)
+
+	t.Fatal("expected Handler to panic")
+}
+
+// passthroughHandler returns an http.Handler that fails the test if it's ever invoked, for use
+// as the next handler behind an injector that's expected to short-circuit before reaching it.
+func passthroughHandler(t *testing.T) http.Handler {
+	t.Helper()
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("next should never be called")
+	})
+}
+
+// testReporter is a Reporter that records every reported (name, state) pair, for assertions
+// about which injector states fired during a test.
+type testReporter struct {
+	mu     sync.Mutex
+	events []testReportEvent
+}
+
+type testReportEvent struct {
+	name  string
+	state InjectorState
+}
+
+func (r *testReporter) Report(name string, state InjectorState) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, testReportEvent{name: name, state: state})
+}
+
+func (r *testReporter) count(name string, state InjectorState) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	n := 0
+	for _, e := range r.events {
+		if e.name == name && e.state == state {
+			n++
+		}
+	}
+
+	return n
+}
+
+func TestConcurrencyLimitInjector_OverflowsBeyondLimitWithZeroMaxWait(t *testing.T) {
+	i, err := NewConcurrencyLimitInjector(1, 0, nil)
+	if err != nil {
+		t.Fatalf("NewConcurrencyLimitInjector: %v", err)
+	}
+
+	rep := &testReporter{}
+	i.SetReporter(rep)
+
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("hold") == "true" {
+			close(entered)
+			<-release
+		}
+	})
+
+	handler := i.Handler(next)
+
+	holderDone := make(chan struct{})
+	go func() {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		req.Header.Set("hold", "true")
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+		close(holderDone)
+	}()
+
+	<-entered // the only slot is now held
+
+	req := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	if got := rep.count(i.Name(), StateOverflow); got != 1 {
+		t.Fatalf("expected 1 overflow report for the request beyond the limit, got %d", got)
+	}
+
+	close(release)
+	<-holderDone
+
+	if got := rep.count(i.Name(), StateFinished); got != 1 {
+		t.Fatalf("expected 1 finished report for the admitted request, got %d", got)
+	}
+}
+
+func TestConcurrencyLimitInjector_MaxWaitAdmitsOnceSlotFrees(t *testing.T) {
+	i, err := NewConcurrencyLimitInjector(1, time.Second, nil)
+	if err != nil {
+		t.Fatalf("NewConcurrencyLimitInjector: %v", err)
+	}
+
+	rep := &testReporter{}
+	i.SetReporter(rep)
+
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("hold") == "true" {
+			close(entered)
+			<-release
+		}
+	})
+
+	handler := i.Handler(next)
+
+	holderDone := make(chan struct{})
+	go func() {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		req.Header.Set("hold", "true")
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+		close(holderDone)
+	}()
+
+	<-entered // the only slot is now held
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+	}()
+
+	req := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	<-holderDone
+
+	if got := rep.count(i.Name(), StateOverflow); got != 0 {
+		t.Fatalf("expected the waiting request to be admitted once the slot freed, got %d overflow reports", got)
+	}
+	if got := rep.count(i.Name(), StateFinished); got != 2 {
+		t.Fatalf("expected both requests to finish, got %d", got)
+	}
+}
+
+func TestConcurrencyLimitInjector_OverflowDispatchesToOverflowInjector(t *testing.T) {
+	overflow, err := NewErrorInjector(http.StatusServiceUnavailable)
+	if err != nil {
+		t.Fatalf("NewErrorInjector: %v", err)
+	}
+
+	i, err := NewConcurrencyLimitInjector(1, 0, overflow)
+	if err != nil {
+		t.Fatalf("NewConcurrencyLimitInjector: %v", err)
+	}
+
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("hold") == "true" {
+			close(entered)
+			<-release
+			return
+		}
+		t.Fatal("next should never be called for the overflowing request")
+	})
+
+	handler := i.Handler(next)
+
+	holderDone := make(chan struct{})
+	go func() {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		req.Header.Set("hold", "true")
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+		close(holderDone)
+	}()
+
+	<-entered // the only slot is now held
+
+	req := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected the overflow injector's response to reach the client, got status %d", rec.Code)
+	}
+
+	close(release)
+	<-holderDone
+}
+
+func TestBandwidthInjector_ThrottlesAndDeliversAllBytes(t *testing.T) {
+	i, err := NewBandwidthInjector(10, 0, 0)
+	if err != nil {
+		t.Fatalf("NewBandwidthInjector: %v", err)
+	}
+
+	sleeps := 0
+	i.sleep = func(d time.Duration) { sleeps++ }
+
+	rec := httptest.NewRecorder()
+	w := newBandwidthResponseWriter(rec, context.Background(), i)
+
+	data := bytes.Repeat([]byte("x"), 35)
+	n, err := w.Write(data)
+	if err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if n != len(data) {
+		t.Fatalf("expected all %d bytes written, got %d", len(data), n)
+	}
+	if sleeps == 0 {
+		t.Fatalf("expected Write to throttle with at least one sleep for a %d-byte write at 10 bytes/sec", len(data))
+	}
+	if rec.Body.Len() != len(data) {
+		t.Fatalf("expected the recorder to receive all bytes, got %d", rec.Body.Len())
+	}
+}
+
+func TestBandwidthInjector_ChunkJitterVariesAcrossRequests(t *testing.T) {
+	i, err := NewBandwidthInjector(10, 0, 100*time.Millisecond)
+	if err != nil {
+		t.Fatalf("NewBandwidthInjector: %v", err)
+	}
+
+	var waits []time.Duration
+	i.sleep = func(d time.Duration) { waits = append(waits, d) }
+
+	data := bytes.Repeat([]byte("x"), 35)
+
+	w1 := newBandwidthResponseWriter(httptest.NewRecorder(), context.Background(), i)
+	if _, err := w1.Write(data); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	first := waits
+
+	waits = nil
+	w2 := newBandwidthResponseWriter(httptest.NewRecorder(), context.Background(), i)
+	if _, err := w2.Write(data); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	second := waits
+
+	if len(first) == 0 || len(second) == 0 {
+		t.Fatalf("expected at least one throttled sleep per request, got %d and %d", len(first), len(second))
+	}
+	if reflect.DeepEqual(first, second) {
+		t.Fatalf("expected chunk jitter to vary across requests sharing the same injector, got identical waits %v twice", first)
+	}
+}
+
+func TestBandwidthInjector_SleepCtxReturnsOnCancellation(t *testing.T) {
+	i, err := NewBandwidthInjector(10, 0, 0)
+	if err != nil {
+		t.Fatalf("NewBandwidthInjector: %v", err)
+	}
+
+	blocked := make(chan struct{})
+	i.sleep = func(d time.Duration) { <-blocked }
+
+	ctx, cancel := context.WithCancel(context.Background())
+	w := newBandwidthResponseWriter(httptest.NewRecorder(), ctx, i)
+
+	result := make(chan bool, 1)
+	go func() {
+		result <- w.sleepCtx(time.Hour)
+	}()
+
+	cancel()
+
+	select {
+	case ok := <-result:
+		if ok {
+			t.Fatal("expected sleepCtx to return false once the context is canceled")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("sleepCtx did not return promptly after context cancellation")
+	}
+
+	close(blocked)
+}
+
+func TestConstantLatencyDistribution_Draw(t *testing.T) {
+	d := NewConstantLatencyDistribution(5 * time.Second)
+	r := rand.New(rand.NewSource(1))
+
+	if got := d.Draw(r); got != 5*time.Second {
+		t.Fatalf("got %v, want 5s", got)
+	}
+}
+
+func TestUniformLatencyDistribution_DrawWithinBounds(t *testing.T) {
+	d, err := NewUniformLatencyDistribution(time.Second, 2*time.Second)
+	if err != nil {
+		t.Fatalf("NewUniformLatencyDistribution: %v", err)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		got := d.Draw(r)
+		if got < time.Second || got >= 2*time.Second {
+			t.Fatalf("draw %v out of [1s, 2s) bounds", got)
+		}
+	}
+}
+
+func TestUniformLatencyDistribution_InvalidRange(t *testing.T) {
+	if _, err := NewUniformLatencyDistribution(2*time.Second, time.Second); !errors.Is(err, ErrInvalidLatencyDistribution) {
+		t.Fatalf("got err %v, want ErrInvalidLatencyDistribution", err)
+	}
+}
+
+func TestNormalLatencyDistribution_DrawNeverNegative(t *testing.T) {
+	d := NewNormalLatencyDistribution(0, time.Second)
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 1000; i++ {
+		if got := d.Draw(r); got < 0 {
+			t.Fatalf("draw %v should never be negative", got)
+		}
+	}
+}
+
+func TestExponentialLatencyDistribution_DrawNonNegative(t *testing.T) {
+	d, err := NewExponentialLatencyDistribution(2)
+	if err != nil {
+		t.Fatalf("NewExponentialLatencyDistribution: %v", err)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		if got := d.Draw(r); got < 0 {
+			t.Fatalf("draw %v should never be negative", got)
+		}
+	}
+}
+
+func TestExponentialLatencyDistribution_InvalidLambda(t *testing.T) {
+	if _, err := NewExponentialLatencyDistribution(0); !errors.Is(err, ErrInvalidLatencyDistribution) {
+		t.Fatalf("got err %v, want ErrInvalidLatencyDistribution", err)
+	}
+	if _, err := NewExponentialLatencyDistribution(-1); !errors.Is(err, ErrInvalidLatencyDistribution) {
+		t.Fatalf("got err %v, want ErrInvalidLatencyDistribution", err)
+	}
+}
+
+func TestParetoLatencyDistribution_DrawAtLeastScale(t *testing.T) {
+	d, err := NewParetoLatencyDistribution(1, 2)
+	if err != nil {
+		t.Fatalf("NewParetoLatencyDistribution: %v", err)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		if got := d.Draw(r); got < time.Second {
+			t.Fatalf("draw %v should never be below the configured scale (1s)", got)
+		}
+	}
+}
+
+func TestParetoLatencyDistribution_InvalidParams(t *testing.T) {
+	if _, err := NewParetoLatencyDistribution(0, 2); !errors.Is(err, ErrInvalidLatencyDistribution) {
+		t.Fatalf("got err %v, want ErrInvalidLatencyDistribution", err)
+	}
+	if _, err := NewParetoLatencyDistribution(1, 0); !errors.Is(err, ErrInvalidLatencyDistribution) {
+		t.Fatalf("got err %v, want ErrInvalidLatencyDistribution", err)
+	}
+}
+
+func TestNewDistributionSlowInjector_SleepsForDrawnDuration(t *testing.T) {
+	i, err := NewDistributionSlowInjector(NewConstantLatencyDistribution(42 * time.Millisecond))
+	if err != nil {
+		t.Fatalf("NewDistributionSlowInjector: %v", err)
+	}
+
+	var slept time.Duration
+	i.sleep = func(d time.Duration) { slept = d }
+
+	called := false
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { called = true })
+
+	req := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+	i.Handler(next).ServeHTTP(httptest.NewRecorder(), req)
+
+	if slept != 42*time.Millisecond {
+		t.Fatalf("got sleep %v, want 42ms", slept)
+	}
+	if !called {
+		t.Fatal("expected next to be called after the sleep")
+	}
+}
+
+func TestDumpReporter_ReportRequestStoresDumps(t *testing.T) {
+	d := NewDumpReporter(0)
+
+	d.ReportRequest("Reject Injector", StateStarted, []byte("GET / HTTP/1.1\r\n\r\n"))
+
+	dumps := d.Dumps()
+	if len(dumps) != 1 {
+		t.Fatalf("expected 1 stored dump, got %d", len(dumps))
+	}
+	if dumps[0].Name != "Reject Injector" || dumps[0].State != StateStarted {
+		t.Fatalf("unexpected stored dump %+v", dumps[0])
+	}
+}